@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Fault header/metadata keys understood by both transports. They're shared
+// so integration-test authors can trigger the same failure modes regardless
+// of whether they're driving the fixture over HTTP or gRPC.
+const (
+	faultDelayKey        = "X-Fault-Delay"
+	faultHTTPStatusKey   = "X-Fault-Status"
+	faultGRPCCodeKey     = "X-Fault-Grpc-Code"
+	faultBodyTruncateKey = "X-Fault-Body-Truncate"
+	faultDropKey         = "X-Fault-Drop"
+)
+
+// FaultConfig describes the failure behavior requested for a single
+// request via headers (HTTP) or metadata (gRPC).
+type FaultConfig struct {
+	// Delay, if non-zero, is slept before the request is otherwise handled.
+	Delay time.Duration
+
+	// HTTPStatus, if non-zero, short-circuits echoHandler with this status.
+	HTTPStatus int
+
+	// GRPCCode, if set, short-circuits the gRPC handler with this code.
+	GRPCCode    codes.Code
+	hasGRPCCode bool
+
+	// BodyTruncate, if non-zero, truncates the echoed body/message to this
+	// many bytes.
+	BodyTruncate int
+
+	// Drop, if true, closes the connection instead of responding over HTTP
+	// (or sends no body when the connection cannot be hijacked), and fails
+	// the call with codes.Unavailable over gRPC.
+	Drop bool
+}
+
+// faultConfigFromHeader parses fault directives out of HTTP request headers.
+func faultConfigFromHeader(h http.Header) FaultConfig {
+	var fc FaultConfig
+
+	if v := h.Get(faultDelayKey); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			fc.Delay = d
+		}
+	}
+	if v := h.Get(faultHTTPStatusKey); v != "" {
+		if code, err := strconv.Atoi(v); err == nil {
+			fc.HTTPStatus = code
+		}
+	}
+	if v := h.Get(faultBodyTruncateKey); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			fc.BodyTruncate = n
+		}
+	}
+	fc.Drop = h.Get(faultDropKey) == "true"
+
+	return fc
+}
+
+// faultConfigFromMetadata parses fault directives out of incoming gRPC
+// metadata, mirroring faultConfigFromHeader.
+func faultConfigFromMetadata(md metadata.MD) FaultConfig {
+	var fc FaultConfig
+
+	if v := firstValue(md, faultDelayKey); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			fc.Delay = d
+		}
+	}
+	if v := firstValue(md, faultGRPCCodeKey); v != "" {
+		// codes.OK is not an error; treating it as one would give the
+		// client a nil response with a nil error, which grpc-go's gRPC
+		// clients aren't expecting and will typically panic on.
+		if code, ok := grpcCodeByName(v); ok && code != codes.OK {
+			fc.GRPCCode = code
+			fc.hasGRPCCode = true
+		}
+	}
+	if v := firstValue(md, faultBodyTruncateKey); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			fc.BodyTruncate = n
+		}
+	}
+	fc.Drop = firstValue(md, faultDropKey) == "true"
+
+	return fc
+}
+
+// grpcCodeByName maps a gRPC status code's canonical protobuf enum name
+// (e.g. "DEADLINE_EXCEEDED", as used by X-Fault-Grpc-Code) to its
+// codes.Code value.
+var grpcCodesByName = map[string]codes.Code{
+	"OK":                  codes.OK,
+	"CANCELLED":           codes.Canceled,
+	"UNKNOWN":             codes.Unknown,
+	"INVALID_ARGUMENT":    codes.InvalidArgument,
+	"DEADLINE_EXCEEDED":   codes.DeadlineExceeded,
+	"NOT_FOUND":           codes.NotFound,
+	"ALREADY_EXISTS":      codes.AlreadyExists,
+	"PERMISSION_DENIED":   codes.PermissionDenied,
+	"RESOURCE_EXHAUSTED":  codes.ResourceExhausted,
+	"FAILED_PRECONDITION": codes.FailedPrecondition,
+	"ABORTED":             codes.Aborted,
+	"OUT_OF_RANGE":        codes.OutOfRange,
+	"UNIMPLEMENTED":       codes.Unimplemented,
+	"INTERNAL":            codes.Internal,
+	"UNAVAILABLE":         codes.Unavailable,
+	"DATA_LOSS":           codes.DataLoss,
+	"UNAUTHENTICATED":     codes.Unauthenticated,
+}
+
+func grpcCodeByName(name string) (codes.Code, bool) {
+	c, ok := grpcCodesByName[name]
+	return c, ok
+}
+
+func firstValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// truncate returns s cut to at most n bytes, or s unchanged if n <= 0.
+func truncate(s string, n int) string {
+	if n <= 0 || n >= len(s) {
+		return s
+	}
+	return s[:n]
+}
+
+// faultHTTPMiddleware applies FaultConfig to echoHandler (and any other
+// handler it wraps): sleeping, returning a canned status, dropping the
+// connection, or truncating the response body as directed by the request.
+func faultHTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fc := faultConfigFromHeader(r.Header)
+
+		if fc.Delay > 0 {
+			time.Sleep(fc.Delay)
+		}
+
+		if fc.Drop {
+			hijacker, ok := w.(http.Hijacker)
+			if ok {
+				conn, _, err := hijacker.Hijack()
+				if err == nil {
+					conn.Close()
+					return
+				}
+			}
+			return
+		}
+
+		if fc.HTTPStatus != 0 {
+			http.Error(w, http.StatusText(fc.HTTPStatus), fc.HTTPStatus)
+			return
+		}
+
+		if fc.BodyTruncate > 0 {
+			rec := &truncatingResponseWriter{ResponseWriter: w, limit: fc.BodyTruncate}
+			next.ServeHTTP(rec, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// truncatingResponseWriter caps the number of body bytes written through to
+// the underlying ResponseWriter, to simulate a partial/truncated response.
+// It keeps its own copy of those bytes so callers that need to know what
+// actually reached the client (e.g. --record) don't have to re-derive the
+// truncation themselves; see Written.
+type truncatingResponseWriter struct {
+	http.ResponseWriter
+	limit   int
+	written int
+	body    []byte
+}
+
+func (w *truncatingResponseWriter) Write(b []byte) (int, error) {
+	if w.written >= w.limit {
+		return len(b), nil
+	}
+	remaining := w.limit - w.written
+	if remaining > len(b) {
+		remaining = len(b)
+	}
+	n, err := w.ResponseWriter.Write(b[:remaining])
+	w.written += n
+	w.body = append(w.body, b[:n]...)
+	return len(b), err
+}
+
+// Written returns the bytes actually forwarded to the underlying
+// ResponseWriter so far, i.e. the response body after truncation.
+func (w *truncatingResponseWriter) Written() []byte {
+	return w.body
+}
+
+// faultUnaryInterceptor applies FaultConfig parsed from incoming gRPC
+// metadata before and after invoking the real handler, so Echo and
+// GetStatus can deterministically fail without changing their own code.
+func faultUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	fc := faultConfigFromMetadata(md)
+
+	if fc.Delay > 0 {
+		time.Sleep(fc.Delay)
+	}
+
+	if fc.Drop {
+		return nil, status.Error(codes.Unavailable, "connection dropped by fault injection")
+	}
+
+	if fc.hasGRPCCode {
+		return nil, status.Error(fc.GRPCCode, "fault injected: "+fc.GRPCCode.String())
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil || fc.BodyTruncate <= 0 {
+		return resp, err
+	}
+
+	if echoResp, ok := resp.(*EchoResponse); ok {
+		echoResp.Message = truncate(echoResp.Message, fc.BodyTruncate)
+	}
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFaultConfigFromHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set(faultDelayKey, "5ms")
+	h.Set(faultHTTPStatusKey, "503")
+	h.Set(faultBodyTruncateKey, "3")
+	h.Set(faultDropKey, "true")
+
+	fc := faultConfigFromHeader(h)
+
+	if fc.Delay != 5*time.Millisecond {
+		t.Errorf("Delay = %v, want 5ms", fc.Delay)
+	}
+	if fc.HTTPStatus != 503 {
+		t.Errorf("HTTPStatus = %d, want 503", fc.HTTPStatus)
+	}
+	if fc.BodyTruncate != 3 {
+		t.Errorf("BodyTruncate = %d, want 3", fc.BodyTruncate)
+	}
+	if !fc.Drop {
+		t.Error("Drop = false, want true")
+	}
+}
+
+func TestFaultConfigFromMetadata(t *testing.T) {
+	md := metadata.Pairs(
+		faultDelayKey, "5ms",
+		faultGRPCCodeKey, "NOT_FOUND",
+		faultBodyTruncateKey, "3",
+	)
+
+	fc := faultConfigFromMetadata(md)
+
+	if fc.Delay != 5*time.Millisecond {
+		t.Errorf("Delay = %v, want 5ms", fc.Delay)
+	}
+	if !fc.hasGRPCCode || fc.GRPCCode != codes.NotFound {
+		t.Errorf("GRPCCode = %v, hasGRPCCode = %v, want NotFound/true", fc.GRPCCode, fc.hasGRPCCode)
+	}
+	if fc.BodyTruncate != 3 {
+		t.Errorf("BodyTruncate = %d, want 3", fc.BodyTruncate)
+	}
+}
+
+func TestFaultConfigFromMetadataIgnoresOK(t *testing.T) {
+	md := metadata.Pairs(faultGRPCCodeKey, "OK")
+
+	fc := faultConfigFromMetadata(md)
+
+	if fc.hasGRPCCode {
+		t.Error("hasGRPCCode = true for X-Fault-Grpc-Code: OK, want false so the real handler still runs")
+	}
+}
+
+func TestTruncatingResponseWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := &truncatingResponseWriter{ResponseWriter: rec, limit: 3}
+
+	n, err := w.Write([]byte("hello"))
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if n != 5 {
+		t.Errorf("Write returned n = %d, want 5 (callers expect the full length)", n)
+	}
+	if rec.Body.String() != "hel" {
+		t.Errorf("underlying body = %q, want %q", rec.Body.String(), "hel")
+	}
+
+	if _, err := w.Write([]byte("world")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if rec.Body.String() != "hel" {
+		t.Errorf("underlying body after limit reached = %q, want unchanged %q", rec.Body.String(), "hel")
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// newGatewayMux builds a grpc-gateway mux that proxies HTTP/JSON requests
+// (e.g. POST /v1/echo, GET /v1/status) to the in-process EchoServer over a
+// loopback gRPC connection, per the google.api.http annotations in echo.proto.
+// The returned *grpc.ClientConn is owned by the caller, who must close it
+// once the gateway is no longer needed.
+func newGatewayMux(ctx context.Context, grpcAddr string) (*runtime.ServeMux, *grpc.ClientConn, error) {
+	conn, err := grpc.DialContext(ctx, "127.0.0.1"+grpcAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mux := runtime.NewServeMux()
+	if err := RegisterEchoServiceHandler(ctx, mux, conn); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return mux, conn, nil
+}
+
+// grpcHandlerFunc multiplexes gRPC and plain HTTP/1.1 traffic on a single
+// handler so both protocols can be served off the same listener. It relies
+// on h2c so gRPC's HTTP/2 requests don't require TLS in this fixture.
+func grpcHandlerFunc(grpcServer *grpc.Server, httpHandler http.Handler) http.Handler {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	})
+	return h2c.NewHandler(h, &http2.Server{})
+}
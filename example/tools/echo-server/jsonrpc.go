@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// jsonrpcVersion is the only JSON-RPC version this fixture speaks.
+const jsonrpcVersion = "2.0"
+
+// jsonrpcRequest is a JSON-RPC 2.0 request envelope.
+type jsonrpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// jsonrpcResponse is a JSON-RPC 2.0 response envelope. Result and Error are
+// mutually exclusive, per spec.
+type jsonrpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// jsonrpcError is a JSON-RPC 2.0 error object.
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Standard JSON-RPC 2.0 error codes.
+const (
+	jsonrpcParseError     = -32700
+	jsonrpcInvalidRequest = -32600
+	jsonrpcMethodNotFound = -32601
+	jsonrpcInvalidParams  = -32602
+)
+
+// echoParams are the params accepted by the "Echo" JSON-RPC method.
+type echoParams struct {
+	Message  string            `json:"message"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// jsonrpcHandler exposes echoService's Echo/GetStatus methods as a JSON-RPC
+// 2.0 endpoint, mounted at /rpc, so polyglot clients that don't want to
+// speak gRPC or the grpc-gateway JSON mapping have a third option.
+func jsonrpcHandler(service *echoService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req jsonrpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSONRPCError(w, nil, jsonrpcParseError, "parse error: "+err.Error())
+			return
+		}
+		defer r.Body.Close()
+
+		if req.JSONRPC != jsonrpcVersion {
+			writeJSONRPCError(w, req.ID, jsonrpcInvalidRequest, "unsupported jsonrpc version")
+			return
+		}
+
+		log.Printf("=== JSON-RPC %s Request ===", req.Method)
+
+		switch req.Method {
+		case "Echo":
+			var params echoParams
+			if len(req.Params) > 0 {
+				if err := json.Unmarshal(req.Params, &params); err != nil {
+					writeJSONRPCError(w, req.ID, jsonrpcInvalidParams, "invalid params: "+err.Error())
+					return
+				}
+			}
+			resp := service.Echo(params.Message, params.Metadata)
+			writeJSONRPCResult(w, req.ID, resp)
+
+		case "GetStatus":
+			resp := service.GetStatus()
+			writeJSONRPCResult(w, req.ID, resp)
+
+		default:
+			writeJSONRPCError(w, req.ID, jsonrpcMethodNotFound, "method not found: "+req.Method)
+		}
+
+		log.Println(strings.Repeat("-", 50))
+	}
+}
+
+func writeJSONRPCResult(w http.ResponseWriter, id json.RawMessage, result interface{}) {
+	writeJSONRPC(w, jsonrpcResponse{JSONRPC: jsonrpcVersion, Result: result, ID: id})
+}
+
+func writeJSONRPCError(w http.ResponseWriter, id json.RawMessage, code int, message string) {
+	writeJSONRPC(w, jsonrpcResponse{
+		JSONRPC: jsonrpcVersion,
+		Error:   &jsonrpcError{Code: code, Message: message},
+		ID:      id,
+	})
+}
+
+func writeJSONRPC(w http.ResponseWriter, resp jsonrpcResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("Error encoding JSON-RPC response: %v", err)
+	}
+}
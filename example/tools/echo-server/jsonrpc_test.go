@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func doJSONRPC(t *testing.T, body string) (*httptest.ResponseRecorder, jsonrpcResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/rpc", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	jsonrpcHandler(&echoService{})(rec, req)
+
+	var resp jsonrpcResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decoding response: %v, body = %s", err, rec.Body.String())
+	}
+	return rec, resp
+}
+
+func TestJSONRPCEcho(t *testing.T) {
+	_, resp := doJSONRPC(t, `{"jsonrpc":"2.0","method":"Echo","params":{"message":"hi"},"id":1}`)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %+v", resp.Error)
+	}
+	if resp.JSONRPC != jsonrpcVersion {
+		t.Errorf("JSONRPC = %q, want %q", resp.JSONRPC, jsonrpcVersion)
+	}
+	if string(resp.ID) != "1" {
+		t.Errorf("ID = %s, want 1", resp.ID)
+	}
+
+	result, ok := resp.Result.(map[string]interface{})
+	if !ok {
+		t.Fatalf("Result = %#v, want an object", resp.Result)
+	}
+	if result["message"] != "hi" {
+		t.Errorf("message = %v, want hi", result["message"])
+	}
+}
+
+func TestJSONRPCGetStatus(t *testing.T) {
+	_, resp := doJSONRPC(t, `{"jsonrpc":"2.0","method":"GetStatus","id":2}`)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %+v", resp.Error)
+	}
+	result, ok := resp.Result.(map[string]interface{})
+	if !ok {
+		t.Fatalf("Result = %#v, want an object", resp.Result)
+	}
+	if result["status"] != "OK" {
+		t.Errorf("status = %v, want OK", result["status"])
+	}
+}
+
+func TestJSONRPCMethodNotFound(t *testing.T) {
+	_, resp := doJSONRPC(t, `{"jsonrpc":"2.0","method":"Nope","id":3}`)
+
+	if resp.Error == nil || resp.Error.Code != jsonrpcMethodNotFound {
+		t.Fatalf("Error = %+v, want code %d", resp.Error, jsonrpcMethodNotFound)
+	}
+}
+
+func TestJSONRPCInvalidVersion(t *testing.T) {
+	_, resp := doJSONRPC(t, `{"jsonrpc":"1.0","method":"Echo","id":4}`)
+
+	if resp.Error == nil || resp.Error.Code != jsonrpcInvalidRequest {
+		t.Fatalf("Error = %+v, want code %d", resp.Error, jsonrpcInvalidRequest)
+	}
+}
+
+func TestJSONRPCInvalidParams(t *testing.T) {
+	_, resp := doJSONRPC(t, `{"jsonrpc":"2.0","method":"Echo","params":"not an object","id":5}`)
+
+	if resp.Error == nil || resp.Error.Code != jsonrpcInvalidParams {
+		t.Fatalf("Error = %+v, want code %d", resp.Error, jsonrpcInvalidParams)
+	}
+}
+
+func TestJSONRPCParseError(t *testing.T) {
+	_, resp := doJSONRPC(t, `not json`)
+
+	if resp.Error == nil || resp.Error.Code != jsonrpcParseError {
+		t.Fatalf("Error = %+v, want code %d", resp.Error, jsonrpcParseError)
+	}
+}
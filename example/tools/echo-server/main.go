@@ -1,17 +1,26 @@
+// Package main implements the echo-server fixture. EchoRequest, EchoResponse,
+// EchoServiceServer and friends are generated from echo.proto; run
+// `go generate` after changing it (requires buf: https://buf.build).
 package main
 
+//go:generate buf generate . --template buf.gen.yaml --path echo.proto
+
 import (
 	"context"
+	"flag"
 	"io"
 	"log"
-	"net"
 	"net/http"
+	"os/signal"
 	"strings"
-	"sync"
+	"syscall"
 	"time"
+)
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+const (
+	httpAddr     = ":8080"
+	grpcAddr     = ":50051"
+	drainTimeout = 10 * time.Second
 )
 
 var startTime = time.Now()
@@ -19,6 +28,8 @@ var startTime = time.Now()
 // EchoServer implements the EchoService
 type EchoServer struct {
 	UnimplementedEchoServiceServer
+
+	service echoService
 }
 
 // Echo implements the Echo RPC method
@@ -26,47 +37,34 @@ func (s *EchoServer) Echo(ctx context.Context, req *EchoRequest) (*EchoResponse,
 	log.Printf("=== gRPC Echo Request ===")
 	log.Printf("Message: %s", req.Message)
 	log.Printf("Metadata: %v", req.Metadata)
-	
-	response := &EchoResponse{
-		Message:   req.Message,
-		Metadata:  req.Metadata,
-		Timestamp: time.Now().Unix(),
-	}
-	
+
+	response := s.service.Echo(req.Message, req.Metadata)
+
 	log.Printf("=== gRPC Echo Response ===")
 	log.Printf("Response: %v", response)
 	log.Println(strings.Repeat("-", 50))
-	
+
 	return response, nil
 }
 
 // GetStatus implements the GetStatus RPC method
 func (s *EchoServer) GetStatus(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
 	log.Printf("=== gRPC GetStatus Request ===")
-	
-	uptime := time.Since(startTime).Seconds()
-	response := &StatusResponse{
-		Status:  "OK",
-		Version: "1.0.0",
-		Uptime:  int64(uptime),
-	}
-	
+
+	response := s.service.GetStatus()
+
 	log.Printf("=== gRPC GetStatus Response ===")
 	log.Printf("Response: %v", response)
 	log.Println(strings.Repeat("-", 50))
-	
+
 	return response, nil
 }
 
-func echoHandler(w http.ResponseWriter, r *http.Request) {
-	// リクエストヘッダーをログ出力
-	log.Println("=== Request Headers ===")
-	for name, values := range r.Header {
-		for _, value := range values {
-			log.Printf("%s: %s", name, value)
-		}
-	}
-
+// echoHandler, when the server isn't in --replay mode, logs and echoes the
+// request back verbatim. In --replay mode it instead serves a canned
+// response matched from the recorded file (see (*Server).replayHTTP), and
+// echoes as usual when no recorded entry matches.
+func (s *Server) echoHandler(w http.ResponseWriter, r *http.Request) {
 	// リクエストボディを読み取り
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -76,6 +74,20 @@ func echoHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	defer r.Body.Close()
 
+	if s.replayStore != nil {
+		if s.replayHTTP(w, r, body) {
+			return
+		}
+	}
+
+	// リクエストヘッダーをログ出力
+	log.Println("=== Request Headers ===")
+	for name, values := range r.Header {
+		for _, value := range values {
+			log.Printf("%s: %s", name, value)
+		}
+	}
+
 	// ボディをログ出力
 	log.Println("=== Request Body ===")
 	if len(body) > 0 {
@@ -106,57 +118,32 @@ func echoHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	log.Println(strings.Repeat("-", 50))
+
+	if s.recorder != nil {
+		respBody := body
+		if tw, ok := w.(*truncatingResponseWriter); ok {
+			respBody = tw.Written()
+		}
+		s.recordHTTP(r, body, respBody)
+	}
 }
 
 func main() {
-	var wg sync.WaitGroup
-	
-	// Start HTTP server
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		
-		// ルートハンドラーを設定
-		http.HandleFunc("/", echoHandler)
-		
-		// サーバー起動ログ
-		port := ":8080"
-		log.Printf("Starting HTTP echo server on port %s", port)
-		log.Println("Send requests to http://localhost:8080")
-		
-		// HTTP サーバー開始
-		if err := http.ListenAndServe(port, nil); err != nil {
-			log.Fatalf("HTTP server failed to start: %v", err)
-		}
-	}()
-	
-	// Start gRPC server
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		
-		lis, err := net.Listen("tcp", ":50051")
-		if err != nil {
-			log.Fatalf("gRPC server failed to listen: %v", err)
-		}
-		
-		grpcServer := grpc.NewServer()
-		RegisterEchoServiceServer(grpcServer, &EchoServer{})
-		
-		// Enable reflection for gRPC clients to discover services
-		reflection.Register(grpcServer)
-		
-		log.Printf("Starting gRPC echo server on port :50051")
-		log.Println("gRPC reflection enabled")
-		
-		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("gRPC server failed to start: %v", err)
-		}
-	}()
-	
-	log.Println("Both HTTP (8080) and gRPC (50051) servers started")
+	recordFile := flag.String("record", "", "append a newline-delimited JSON log of every request/response to this file")
+	replayFile := flag.String("replay", "", "serve canned responses matched from a file previously written with --record, instead of echoing")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	log.Println("Send requests to http://localhost" + httpAddr)
 	log.Println("Press Ctrl+C to stop")
-	
-	// Wait for both servers
-	wg.Wait()
+
+	srv := NewServer(httpAddr, grpcAddr, drainTimeout)
+	srv.RecordFile = *recordFile
+	srv.ReplayFile = *replayFile
+
+	if err := srv.Run(ctx); err != nil {
+		log.Fatalf("server failed: %v", err)
+	}
 }
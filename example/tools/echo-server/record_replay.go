@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/katonium/integration-test-platform/example/tools/echo-server/replay"
+)
+
+// recordHTTP appends the just-served HTTP request/response to s.recorder.
+// respBody is what was actually written to the client, which may differ
+// from reqBody (echoHandler's echoed body) if a fault truncated it.
+func (s *Server) recordHTTP(r *http.Request, reqBody, respBody []byte) {
+	entry := RecordEntry{
+		Timestamp: time.Now(),
+		Protocol:  "http",
+		Method:    r.URL.Path,
+		Headers:   map[string][]string(r.Header),
+		Request:   reqBody,
+		Response:  respBody,
+		Status:    "200",
+	}
+	if err := s.recorder.Record(entry); err != nil {
+		log.Printf("Error recording entry: %v", err)
+	}
+}
+
+// replayHTTP attempts to serve w from s.replayStore instead of echoing,
+// given the already-read request body. It reports whether it handled the
+// request.
+func (s *Server) replayHTTP(w http.ResponseWriter, r *http.Request, body []byte) bool {
+	entry, ok := s.replayStore.Match(replay.Request{
+		Method:  r.URL.Path,
+		Headers: map[string][]string(r.Header),
+		Body:    body,
+	})
+	if !ok {
+		return false
+	}
+
+	log.Printf("=== Replaying recorded response for %s ===", r.URL.Path)
+	w.WriteHeader(http.StatusOK)
+	w.Write(entry.Response)
+	return true
+}
+
+// recordReplayUnaryInterceptor serves canned gRPC responses in --replay
+// mode, matching the request against s.replayStore by JSON-encoded body,
+// and appends every unary call to s.recorder in --record mode.
+func (s *Server) recordReplayUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	reqBody, _ := json.Marshal(req)
+
+	if s.replayStore != nil {
+		if entry, ok := s.replayStore.Match(replay.Request{Method: info.FullMethod, Body: reqBody}); ok {
+			log.Printf("=== Replaying recorded response for %s ===", info.FullMethod)
+			resp := newResponseFor(info.FullMethod)
+			if err := json.Unmarshal(entry.Response, resp); err == nil {
+				return resp, nil
+			}
+		}
+	}
+
+	resp, err := handler(ctx, req)
+
+	if s.recorder != nil {
+		respBody, _ := json.Marshal(resp)
+		status := "OK"
+		if err != nil {
+			status = err.Error()
+		}
+		recErr := s.recorder.Record(RecordEntry{
+			Timestamp: time.Now(),
+			Protocol:  "grpc",
+			Method:    info.FullMethod,
+			Request:   reqBody,
+			Response:  respBody,
+			Status:    status,
+		})
+		if recErr != nil {
+			log.Printf("Error recording entry: %v", recErr)
+		}
+	}
+
+	return resp, err
+}
+
+// newResponseFor returns a pointer to the zero value of the response type
+// for a unary EchoService method, so a recorded JSON body can be unmarshaled
+// into the right concrete type during replay.
+func newResponseFor(fullMethod string) interface{} {
+	switch fullMethod {
+	case "/echoserver.EchoService/GetStatus":
+		return &StatusResponse{}
+	default:
+		return &EchoResponse{}
+	}
+}
+
+// recordReplayStreamInterceptor wraps every streaming RPC
+// (ServerStreamEcho, ClientStreamEcho, BidiEcho) for --record/--replay.
+// Each Recv is appended to s.recorder as a "recv" entry carrying only the
+// request, and each Send as a "send" entry carrying only the response. In
+// --replay mode SendMsg looks up the first entry matching the method and
+// the last received request and sends its response in place of the
+// handler's when that response decodes; in a file recorded by this server
+// that entry is the "recv" one, which has no response, so the handler's
+// own responses are sent.
+func (s *Server) recordReplayStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &recordReplayServerStream{ServerStream: ss, server: s, method: info.FullMethod})
+}
+
+// recordReplayServerStream decorates a grpc.ServerStream so streaming.go's
+// handlers don't need to know about recording or replay at all.
+type recordReplayServerStream struct {
+	grpc.ServerStream
+	server  *Server
+	method  string
+	lastReq []byte
+}
+
+func (w *recordReplayServerStream) RecvMsg(m interface{}) error {
+	if err := w.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	w.lastReq, _ = json.Marshal(m)
+	if w.server.recorder != nil {
+		if err := w.server.recorder.Record(RecordEntry{
+			Timestamp: time.Now(),
+			Protocol:  "grpc-stream",
+			Method:    w.method,
+			Request:   w.lastReq,
+			Status:    "recv",
+		}); err != nil {
+			log.Printf("Error recording entry: %v", err)
+		}
+	}
+	return nil
+}
+
+func (w *recordReplayServerStream) SendMsg(m interface{}) error {
+	if w.server.replayStore != nil {
+		if entry, ok := w.server.replayStore.Match(replay.Request{Method: w.method, Body: w.lastReq}); ok {
+			log.Printf("=== Replaying recorded stream response for %s ===", w.method)
+			if err := json.Unmarshal(entry.Response, m); err == nil {
+				return w.ServerStream.SendMsg(m)
+			}
+		}
+	}
+
+	err := w.ServerStream.SendMsg(m)
+	if err == nil && w.server.recorder != nil {
+		respBody, _ := json.Marshal(m)
+		if recErr := w.server.recorder.Record(RecordEntry{
+			Timestamp: time.Now(),
+			Protocol:  "grpc-stream",
+			Method:    w.method,
+			Response:  respBody,
+			Status:    "send",
+		}); recErr != nil {
+			log.Printf("Error recording entry: %v", recErr)
+		}
+	}
+	return err
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/katonium/integration-test-platform/example/tools/echo-server/replay"
+)
+
+const testFullMethod = "/echoserver.EchoService/Echo"
+
+func echoHandlerFunc(message string) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &EchoResponse{Message: message}, nil
+	}
+}
+
+func TestRecordReplayUnaryRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "record.ndjson")
+
+	rec, err := NewRecorder(path)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	recordServer := &Server{recorder: rec}
+
+	req := &EchoRequest{Message: "hi"}
+	info := &grpc.UnaryServerInfo{FullMethod: testFullMethod}
+
+	resp, err := recordServer.recordReplayUnaryInterceptor(context.Background(), req, info, echoHandlerFunc("hi"))
+	if err != nil {
+		t.Fatalf("recordReplayUnaryInterceptor: %v", err)
+	}
+	if resp.(*EchoResponse).Message != "hi" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	store, err := replay.Load(path, replay.Options{})
+	if err != nil {
+		t.Fatalf("replay.Load: %v", err)
+	}
+	replayServer := &Server{replayStore: store}
+
+	panicHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler should not be invoked on a replay hit")
+		return nil, nil
+	}
+
+	replayed, err := replayServer.recordReplayUnaryInterceptor(context.Background(), req, info, panicHandler)
+	if err != nil {
+		t.Fatalf("recordReplayUnaryInterceptor (replay): %v", err)
+	}
+	if replayed.(*EchoResponse).Message != "hi" {
+		t.Fatalf("replayed response = %+v, want Message 'hi'", replayed)
+	}
+}
+
+func TestRecordReplayUnaryRecordsPostFaultResponse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "record.ndjson")
+
+	rec, err := NewRecorder(path)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	s := &Server{recorder: rec}
+
+	md := metadata.Pairs(faultBodyTruncateKey, "2")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	req := &EchoRequest{Message: "hello"}
+	info := &grpc.UnaryServerInfo{FullMethod: testFullMethod}
+
+	// Mirror the production chain order: recordReplayUnaryInterceptor
+	// wraps faultUnaryInterceptor, so it must see (and record) the
+	// truncated response, not the handler's untruncated one.
+	resp, err := s.recordReplayUnaryInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return faultUnaryInterceptor(ctx, req, info, echoHandlerFunc("hello"))
+	})
+	if err != nil {
+		t.Fatalf("recordReplayUnaryInterceptor: %v", err)
+	}
+	if got := resp.(*EchoResponse).Message; got != "he" {
+		t.Fatalf("client response = %q, want truncated %q", got, "he")
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	store, err := replay.Load(path, replay.Options{})
+	if err != nil {
+		t.Fatalf("replay.Load: %v", err)
+	}
+
+	reqBody, _ := json.Marshal(req)
+	entry, ok := store.Match(replay.Request{Method: testFullMethod, Body: reqBody})
+	if !ok {
+		t.Fatal("expected recorded entry to match")
+	}
+
+	var recordedResp EchoResponse
+	if err := json.Unmarshal(entry.Response, &recordedResp); err != nil {
+		t.Fatalf("unmarshal recorded response: %v", err)
+	}
+	if recordedResp.Message != "he" {
+		t.Fatalf("recorded response = %q, want the truncated %q that was actually served", recordedResp.Message, "he")
+	}
+}
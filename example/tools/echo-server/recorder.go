@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// RecordEntry is one captured request/response pair, appended as a single
+// line of newline-delimited JSON to the --record file. The replay subpackage
+// reads back files in this exact shape.
+type RecordEntry struct {
+	Timestamp time.Time           `json:"timestamp"`
+	Protocol  string              `json:"protocol"` // "http", "grpc", or "grpc-stream"
+	Method    string              `json:"method"`   // URL path for http, full RPC method name for grpc/grpc-stream
+	Headers   map[string][]string `json:"headers,omitempty"`
+	Request   []byte              `json:"request"`
+	Response  []byte              `json:"response"`
+	Status    string              `json:"status"`
+}
+
+// Recorder appends RecordEntry values to an NDJSON file so a --replay run
+// can later serve canned responses instead of echoing live traffic.
+type Recorder struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+// NewRecorder opens (creating if necessary, appending if it already exists)
+// the file at path for recording.
+func NewRecorder(path string) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+// Record appends entry as a single NDJSON line.
+func (r *Recorder) Record(entry RecordEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.enc.Encode(entry)
+}
+
+// Close closes the underlying file.
+func (r *Recorder) Close() error {
+	return r.f.Close()
+}
@@ -0,0 +1,210 @@
+// Package replay turns a file recorded by echo-server's --record mode into
+// a deterministic mock: incoming requests are matched against recorded
+// entries and served the canned response instead of being echoed live.
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// Entry mirrors the NDJSON shape written by the main package's Recorder.
+type Entry struct {
+	Timestamp time.Time           `json:"timestamp"`
+	Protocol  string              `json:"protocol"`
+	Method    string              `json:"method"`
+	Headers   map[string][]string `json:"headers,omitempty"`
+	Request   []byte              `json:"request"`
+	Response  []byte              `json:"response"`
+	Status    string              `json:"status"`
+}
+
+// Request is the incoming request a Matcher is asked to judge.
+type Request struct {
+	Method  string
+	Headers map[string][]string
+	Body    []byte
+}
+
+// Matcher decides whether a live Request corresponds to a recorded Entry.
+type Matcher interface {
+	Match(Request) bool
+}
+
+// ExactBody matches when the request body is byte-identical to the
+// recorded one.
+type ExactBody struct {
+	Body []byte
+}
+
+func (m ExactBody) Match(r Request) bool {
+	return string(r.Body) == string(m.Body)
+}
+
+// HeaderSubset matches when every key/value pair in Headers is present in
+// the request's headers (the request may carry additional headers).
+type HeaderSubset struct {
+	Headers map[string]string
+}
+
+func (m HeaderSubset) Match(r Request) bool {
+	for k, v := range m.Headers {
+		values, ok := r.Headers[k]
+		if !ok {
+			return false
+		}
+		found := false
+		for _, rv := range values {
+			if rv == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// RegexPath matches when the request's Method (an HTTP path or RPC name)
+// matches the given pattern.
+type RegexPath struct {
+	Pattern Regexp
+}
+
+// Regexp is the subset of *regexp.Regexp that RegexPath needs, so callers
+// can pass a real *regexp.Regexp without this package importing regexp
+// into every matcher's API.
+type Regexp interface {
+	MatchString(string) bool
+}
+
+func (m RegexPath) Match(r Request) bool {
+	return m.Pattern.MatchString(r.Method)
+}
+
+// Order controls how a Store picks among multiple entries that match the
+// same request.
+type Order int
+
+const (
+	// OrderLoop replays matching entries round-robin, looping forever.
+	OrderLoop Order = iota
+	// OrderOnce serves each matching entry at most once, in recorded order.
+	OrderOnce
+)
+
+// Options configures a Store.
+type Options struct {
+	// TTL, if non-zero, expires an entry this long after it was recorded;
+	// expired entries are skipped during matching.
+	TTL time.Duration
+	// Order selects looping vs. once-only replay of matching entries.
+	Order Order
+}
+
+type stored struct {
+	entry   Entry
+	matcher Matcher
+	used    bool
+}
+
+// Store holds recorded entries and serves canned responses for requests
+// that match them.
+type Store struct {
+	mu      sync.Mutex
+	opts    Options
+	entries []*stored
+}
+
+// Load reads an NDJSON file of Entry values written by the main package's
+// Recorder and builds a Store that matches incoming requests against them
+// by exact request-body equality.
+func Load(path string, opts Options) (*Store, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s := &Store{opts: opts}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, err
+		}
+		s.entries = append(s.entries, &stored{entry: e, matcher: ExactBody{Body: e.Request}})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Match returns a stored entry whose matcher accepts req, honoring TTL and
+// Order: under OrderOnce the first match not yet served, under OrderLoop
+// each match in turn, starting over once all of them have been served. An
+// entry with a non-empty recorded Method only matches a req with that same
+// Method. ok is false if nothing matches.
+func (s *Store) Match(req Request) (entry Entry, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var served []*stored // OrderLoop matches already served this round
+	for _, se := range s.entries {
+		if s.opts.Order == OrderOnce && se.used {
+			continue
+		}
+		if s.opts.TTL > 0 && now.Sub(se.entry.Timestamp) > s.opts.TTL {
+			continue
+		}
+		if se.entry.Method != "" && se.entry.Method != req.Method {
+			continue
+		}
+		if !se.matcher.Match(req) {
+			continue
+		}
+		if se.used {
+			served = append(served, se)
+			continue
+		}
+		se.used = true
+		return se.entry, true
+	}
+	if len(served) > 0 {
+		// Every match has been served this round; start the next one.
+		for _, se := range served {
+			se.used = false
+		}
+		served[0].used = true
+		return served[0].entry, true
+	}
+	return Entry{}, false
+}
+
+// WithMatcher overrides the matcher used for the i'th loaded entry,
+// letting callers opt specific entries into HeaderSubset or RegexPath
+// matching instead of the default ExactBody. Match checks the entry's
+// recorded Method before consulting the matcher, so a RegexPath matcher on
+// an entry with a non-empty Method only sees requests with exactly that
+// Method.
+func (s *Store) WithMatcher(i int, m Matcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i >= 0 && i < len(s.entries) {
+		s.entries[i].matcher = m
+	}
+}
@@ -0,0 +1,88 @@
+package replay
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestExactBodyMatch(t *testing.T) {
+	m := ExactBody{Body: []byte(`{"message":"hi"}`)}
+
+	if !m.Match(Request{Body: []byte(`{"message":"hi"}`)}) {
+		t.Error("expected identical bodies to match")
+	}
+	if m.Match(Request{Body: []byte(`{"message":"bye"}`)}) {
+		t.Error("expected different bodies not to match")
+	}
+}
+
+func TestHeaderSubsetMatch(t *testing.T) {
+	m := HeaderSubset{Headers: map[string]string{"X-Test": "a"}}
+
+	ok := m.Match(Request{Headers: map[string][]string{
+		"X-Test":  {"a"},
+		"X-Extra": {"b"},
+	}})
+	if !ok {
+		t.Error("expected request with the required header (plus extras) to match")
+	}
+
+	if m.Match(Request{Headers: map[string][]string{"X-Test": {"b"}}}) {
+		t.Error("expected mismatched header value not to match")
+	}
+	if m.Match(Request{Headers: map[string][]string{}}) {
+		t.Error("expected missing header not to match")
+	}
+}
+
+func TestRegexPathMatch(t *testing.T) {
+	m := RegexPath{Pattern: regexp.MustCompile(`^/v1/echo.*`)}
+
+	if !m.Match(Request{Method: "/v1/echo/now"}) {
+		t.Error("expected matching path to match")
+	}
+	if m.Match(Request{Method: "/v1/status"}) {
+		t.Error("expected non-matching path not to match")
+	}
+}
+
+func TestStoreMatchHonorsMethod(t *testing.T) {
+	s := &Store{entries: []*stored{
+		{entry: Entry{Method: "/v1/echo", Response: []byte("echo")}, matcher: ExactBody{}},
+		{entry: Entry{Method: "/v1/status", Response: []byte("status")}, matcher: ExactBody{}},
+	}}
+
+	entry, ok := s.Match(Request{Method: "/v1/status"})
+	if !ok || string(entry.Response) != "status" {
+		t.Fatalf("expected /v1/status entry, got %+v, ok=%v", entry, ok)
+	}
+}
+
+func TestStoreMatchOrderOnce(t *testing.T) {
+	s := &Store{
+		opts:    Options{Order: OrderOnce},
+		entries: []*stored{{entry: Entry{Response: []byte("first")}, matcher: ExactBody{}}},
+	}
+
+	if _, ok := s.Match(Request{}); !ok {
+		t.Fatal("expected first match to succeed")
+	}
+	if _, ok := s.Match(Request{}); ok {
+		t.Fatal("expected entry to be consumed after OrderOnce match")
+	}
+}
+
+func TestStoreMatchTTLExpiry(t *testing.T) {
+	s := &Store{
+		opts: Options{TTL: time.Minute},
+		entries: []*stored{{
+			entry:   Entry{Timestamp: time.Now().Add(-time.Hour), Response: []byte("stale")},
+			matcher: ExactBody{},
+		}},
+	}
+
+	if _, ok := s.Match(Request{}); ok {
+		t.Error("expected expired entry not to match")
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/katonium/integration-test-platform/example/tools/echo-server/replay"
+)
+
+// Server owns the HTTP and gRPC listeners for the echo fixture and
+// coordinates a synchronous startup plus a graceful, time-bounded shutdown.
+// It exists so integration tests can embed the fixture and tear it down
+// cleanly between cases instead of relying on log.Fatalf and a bare wg.Wait.
+type Server struct {
+	HTTPAddr string
+	GRPCAddr string
+
+	// DrainTimeout bounds how long Shutdown/GracefulStop are given to
+	// finish in-flight requests before the listeners are force-closed.
+	DrainTimeout time.Duration
+
+	// RecordFile, if set, appends every plain HTTP echo request and every
+	// gRPC call (including those reached through the /v1/ gateway) with its
+	// response to this NDJSON file; JSON-RPC calls on /rpc are not
+	// recorded. ReplayFile, if set, serves canned responses matched from a
+	// file previously written with RecordFile instead of echoing. The two
+	// are mutually exclusive.
+	RecordFile string
+	ReplayFile string
+
+	echo *EchoServer
+
+	recorder    *Recorder
+	replayStore *replay.Store
+
+	httpSrv *http.Server
+	grpcSrv *grpc.Server
+	gwConn  *grpc.ClientConn
+	group   *errgroup.Group
+}
+
+// NewServer constructs a Server with its listeners unbound.
+func NewServer(httpAddr, grpcAddr string, drainTimeout time.Duration) *Server {
+	return &Server{
+		HTTPAddr:     httpAddr,
+		GRPCAddr:     grpcAddr,
+		DrainTimeout: drainTimeout,
+		echo:         &EchoServer{},
+	}
+}
+
+// Run binds both listeners synchronously, returning a combined error if
+// either fails to bind, then serves them until ctx is cancelled (typically
+// by SIGINT/SIGTERM). On cancellation it performs a graceful shutdown
+// bounded by DrainTimeout before returning.
+func (s *Server) Run(ctx context.Context) error {
+	if s.RecordFile != "" && s.ReplayFile != "" {
+		return errors.New("--record and --replay are mutually exclusive")
+	}
+
+	if s.RecordFile != "" {
+		rec, err := NewRecorder(s.RecordFile)
+		if err != nil {
+			return err
+		}
+		s.recorder = rec
+	}
+	if s.ReplayFile != "" {
+		store, err := replay.Load(s.ReplayFile, replay.Options{Order: replay.OrderLoop})
+		if err != nil {
+			return err
+		}
+		s.replayStore = store
+	}
+
+	grpcLis, grpcErr := net.Listen("tcp", s.GRPCAddr)
+	httpLis, httpErr := net.Listen("tcp", s.HTTPAddr)
+	if err := errors.Join(grpcErr, httpErr); err != nil {
+		if grpcLis != nil {
+			grpcLis.Close()
+		}
+		if httpLis != nil {
+			httpLis.Close()
+		}
+		return err
+	}
+
+	s.grpcSrv = grpc.NewServer(
+		// recordReplayUnaryInterceptor must be outermost so it records (or
+		// replays) whatever faultUnaryInterceptor actually returns, e.g. a
+		// response truncated by X-Fault-Body-Truncate, not the untruncated
+		// response the real handler produced.
+		grpc.ChainUnaryInterceptor(s.recordReplayUnaryInterceptor, faultUnaryInterceptor),
+		grpc.ChainStreamInterceptor(s.recordReplayStreamInterceptor),
+	)
+	RegisterEchoServiceServer(s.grpcSrv, s.echo)
+	reflection.Register(s.grpcSrv)
+
+	gwMux, gwConn, err := newGatewayMux(ctx, s.GRPCAddr)
+	if err != nil {
+		grpcLis.Close()
+		httpLis.Close()
+		return err
+	}
+	s.gwConn = gwConn
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gwMux)
+	mux.HandleFunc("/rpc", jsonrpcHandler(&s.echo.service))
+	mux.HandleFunc("/", s.echoHandler)
+	s.httpSrv = &http.Server{
+		Addr:    s.HTTPAddr,
+		Handler: grpcHandlerFunc(s.grpcSrv, faultHTTPMiddleware(mux)),
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	s.group = g
+
+	g.Go(func() error {
+		log.Printf("Starting HTTP echo server on %s", s.HTTPAddr)
+		if err := s.httpSrv.Serve(httpLis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		log.Printf("Starting gRPC echo server on %s", s.GRPCAddr)
+		log.Println("gRPC reflection enabled")
+		if err := s.grpcSrv.Serve(grpcLis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		<-gCtx.Done()
+		s.shutdown()
+		return nil
+	})
+
+	log.Printf("Both HTTP (%s) and gRPC (%s) servers started", s.HTTPAddr, s.GRPCAddr)
+	return g.Wait()
+}
+
+// shutdown drains the HTTP and gRPC servers within DrainTimeout, falling
+// back to a hard Stop/Close if the drain doesn't finish in time.
+func (s *Server) shutdown() {
+	log.Println("Shutdown signal received, draining connections...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.DrainTimeout)
+	defer cancel()
+
+	if err := s.httpSrv.Shutdown(ctx); err != nil {
+		log.Printf("HTTP graceful shutdown failed: %v", err)
+		s.httpSrv.Close()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Println("Drain timeout exceeded, forcing gRPC server to stop")
+		s.grpcSrv.Stop()
+	}
+
+	if err := s.gwConn.Close(); err != nil {
+		log.Printf("Error closing gateway connection: %v", err)
+	}
+
+	if s.recorder != nil {
+		if err := s.recorder.Close(); err != nil {
+			log.Printf("Error closing recorder: %v", err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// freePort returns an ephemeral TCP port that was free at the time of the
+// call, in the bare ":PORT" form Server.HTTPAddr and Server.GRPCAddr expect.
+func freePort(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen: %v", err)
+	}
+	defer l.Close()
+	return fmt.Sprintf(":%d", l.Addr().(*net.TCPAddr).Port)
+}
+
+func waitForListener(t *testing.T, addr string) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		conn, err := net.DialTimeout("tcp", "127.0.0.1"+addr, 50*time.Millisecond)
+		if err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("nothing listening on %s after 2s", addr)
+}
+
+// TestServerRunServesAndShutsDownCleanly exercises Server.Run's core
+// promise: synchronous bind, serve real traffic, then drain and return
+// promptly once ctx is cancelled, so integration tests can embed the
+// fixture and tear it down cleanly between cases.
+func TestServerRunServesAndShutsDownCleanly(t *testing.T) {
+	httpAddr := freePort(t)
+	grpcAddr := freePort(t)
+
+	s := NewServer(httpAddr, grpcAddr, 2*time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	waitForListener(t, httpAddr)
+
+	resp, err := http.Post("http://127.0.0.1"+httpAddr+"/", "text/plain", strings.NewReader("hi"))
+	if err != nil {
+		t.Fatalf("POST to echo server: %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("reading response body: %v", err)
+	}
+	if string(body) != "hi" {
+		t.Fatalf("echoed body = %q, want %q", body, "hi")
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run returned error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return within DrainTimeout after context cancellation")
+	}
+
+	if conn, err := net.DialTimeout("tcp", "127.0.0.1"+httpAddr, 100*time.Millisecond); err == nil {
+		conn.Close()
+		t.Error("expected HTTP listener to be closed after shutdown")
+	}
+}
+
+// TestServerGatewayProxiesToGRPC exercises the grpc-gateway mux wired into
+// Server.Run: HTTP/JSON requests under /v1/ should reach the same
+// EchoService the gRPC listener serves, per the google.api.http
+// annotations in echo.proto.
+func TestServerGatewayProxiesToGRPC(t *testing.T) {
+	httpAddr := freePort(t)
+	grpcAddr := freePort(t)
+
+	s := NewServer(httpAddr, grpcAddr, 2*time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	waitForListener(t, httpAddr)
+
+	echoResp, err := http.Post("http://127.0.0.1"+httpAddr+"/v1/echo", "application/json",
+		strings.NewReader(`{"message":"hi"}`))
+	if err != nil {
+		t.Fatalf("POST /v1/echo: %v", err)
+	}
+	defer echoResp.Body.Close()
+	if echoResp.StatusCode != http.StatusOK {
+		t.Fatalf("POST /v1/echo status = %d, want 200", echoResp.StatusCode)
+	}
+	body, err := io.ReadAll(echoResp.Body)
+	if err != nil {
+		t.Fatalf("reading /v1/echo response: %v", err)
+	}
+	if !strings.Contains(string(body), `"message":"hi"`) {
+		t.Errorf("/v1/echo response = %s, want it to contain %q", body, `"message":"hi"`)
+	}
+
+	statusResp, err := http.Get("http://127.0.0.1" + httpAddr + "/v1/status")
+	if err != nil {
+		t.Fatalf("GET /v1/status: %v", err)
+	}
+	defer statusResp.Body.Close()
+	if statusResp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /v1/status status = %d, want 200", statusResp.StatusCode)
+	}
+	body, err = io.ReadAll(statusResp.Body)
+	if err != nil {
+		t.Fatalf("reading /v1/status response: %v", err)
+	}
+	if !strings.Contains(string(body), `"status":"OK"`) {
+		t.Errorf("/v1/status response = %s, want it to contain %q", body, `"status":"OK"`)
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import "time"
+
+// echoService holds the Echo/GetStatus logic shared by gRPC (and the /v1/
+// gateway routes that proxy to it) and JSON-RPC, so they stay consistent
+// instead of each protocol re-implementing the echo behavior. The plain
+// HTTP echoHandler does not use it; it echoes the raw request body.
+type echoService struct{}
+
+// Echo returns the message and metadata unchanged, stamped with the
+// current time.
+func (s *echoService) Echo(message string, metadata map[string]string) *EchoResponse {
+	return &EchoResponse{
+		Message:   message,
+		Metadata:  metadata,
+		Timestamp: time.Now().Unix(),
+	}
+}
+
+// GetStatus reports the server's health and uptime.
+func (s *echoService) GetStatus() *StatusResponse {
+	uptime := time.Since(startTime).Seconds()
+	return &StatusResponse{
+		Status:  "OK",
+		Version: "1.0.0",
+		Uptime:  int64(uptime),
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// defaultChunkCount is how many chunks ServerStreamEcho emits when the
+// caller doesn't request a specific count via metadata.
+const defaultChunkCount = 3
+
+// chunkCountMetadataKey lets callers control how many chunks
+// ServerStreamEcho emits, e.g. metadata.Pairs("x-chunk-count", "5").
+const chunkCountMetadataKey = "x-chunk-count"
+
+// ServerStreamEcho sends count EchoResponses (see chunkCountFromContext),
+// each echoing req unchanged, letting integration tests exercise
+// server-side streaming against a predictable fixture.
+func (s *EchoServer) ServerStreamEcho(req *EchoRequest, stream EchoService_ServerStreamEchoServer) error {
+	log.Printf("=== gRPC ServerStreamEcho Request ===")
+	log.Printf("Message: %s", req.Message)
+
+	count := chunkCountFromContext(stream.Context())
+
+	for i := 0; i < count; i++ {
+		resp := s.service.Echo(req.Message, req.Metadata)
+		log.Printf("Sending chunk %d/%d: %v", i+1, count, resp)
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+
+	log.Println(strings.Repeat("-", 50))
+	return nil
+}
+
+// ClientStreamEcho reads EchoRequests until the client closes the stream,
+// then replies with a single EchoResponse concatenating every message.
+func (s *EchoServer) ClientStreamEcho(stream EchoService_ClientStreamEchoServer) error {
+	log.Printf("=== gRPC ClientStreamEcho ===")
+
+	var messages []string
+	var meta map[string]string
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		log.Printf("Received chunk: %s", req.Message)
+		messages = append(messages, req.Message)
+		meta = req.Metadata
+	}
+
+	resp := s.service.Echo(strings.Join(messages, ""), meta)
+	log.Printf("Sending concatenated response: %v", resp)
+	log.Println(strings.Repeat("-", 50))
+
+	return stream.SendAndClose(resp)
+}
+
+// BidiEcho uppercases and echoes back each EchoRequest as it arrives,
+// exercising full-duplex streaming.
+func (s *EchoServer) BidiEcho(stream EchoService_BidiEchoServer) error {
+	log.Printf("=== gRPC BidiEcho ===")
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			log.Println(strings.Repeat("-", 50))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		log.Printf("Received: %s", req.Message)
+
+		resp := &EchoResponse{
+			Message:   strings.ToUpper(req.Message),
+			Metadata:  req.Metadata,
+			Timestamp: time.Now().Unix(),
+		}
+		log.Printf("Sending: %v", resp)
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// chunkCountFromContext reads the chunk count requested via incoming gRPC
+// metadata, falling back to defaultChunkCount when absent or invalid.
+func chunkCountFromContext(ctx context.Context) int {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return defaultChunkCount
+	}
+	values := md.Get(chunkCountMetadataKey)
+	if len(values) == 0 {
+		return defaultChunkCount
+	}
+	count, err := strconv.Atoi(values[0])
+	if err != nil || count <= 0 {
+		return defaultChunkCount
+	}
+	return count
+}